@@ -0,0 +1,65 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec/internal/helpers/messages"
+)
+
+// ErrUnsupportedVersion occurs when the installed Git version is lower than
+// the version a specific feature requires. Unlike ErrGitLowerVersion, it
+// carries the required and the found version; it does not name the feature.
+type ErrUnsupportedVersion struct {
+	Required string
+	Found    string
+}
+
+func (e *ErrUnsupportedVersion) Error() string {
+	return fmt.Sprintf("git version %s or later is required, found %s", e.Required, e.Found)
+}
+
+// IsErrUnsupportedVersion reports whether err is, or wraps, an ErrUnsupportedVersion.
+func IsErrUnsupportedVersion(err error) bool {
+	var target *ErrUnsupportedVersion
+	return errors.As(err, &target)
+}
+
+// RequireAtLeast checks the installed Git version against required (e.g.
+// "2.23.0"), so callers such as analyzers, commit-authors and leaks scanning
+// can declare their own minimum version at their call site instead of being
+// locked to the single global MinVersion. It returns an *ErrUnsupportedVersion
+// when the installed version is too old, and logs the same install guidance
+// ValidateGit does.
+func RequireAtLeast(required string) error {
+	requiredVersion, err := ParseVersion(required)
+	if err != nil {
+		return fmt.Errorf("invalid required git version %q: %w", required, err)
+	}
+	found, err := GetGitVersion()
+	if err != nil {
+		logger.LogInfo(messages.MsgInfoHowToInstallGit)
+		return err
+	}
+	if !found.AtLeast(requiredVersion) {
+		logger.LogInfo(messages.MsgInfoHowToInstallGit)
+		return &ErrUnsupportedVersion{Required: requiredVersion.String(), Found: found.String()}
+	}
+	return nil
+}
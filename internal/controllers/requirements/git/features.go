@@ -0,0 +1,75 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import "sync"
+
+// GitFeatures caches the set of optional Git capabilities available given the
+// resolved installed version, so consumers can branch on a boolean instead
+// of reparsing `git --version` or shelling out probes of their own.
+type GitFeatures struct {
+	SupportsShallowSince        bool
+	SupportsPartialClone        bool
+	SupportsSparseCheckoutCone  bool
+	SupportsCommitGraph         bool
+	SupportsBlameIgnoreRevsFile bool
+}
+
+var (
+	// shallowSinceMinVersion is the minimum version required for `--shallow-since`.
+	shallowSinceMinVersion = &Version{Major: 1, Minor: 9, Patch: 0}
+	// partialCloneMinVersion is the minimum version required for `--filter=blob:none`.
+	partialCloneMinVersion = &Version{Major: 2, Minor: 19, Patch: 0}
+	// sparseCheckoutConeMinVersion is the minimum version required for cone mode sparse checkouts.
+	sparseCheckoutConeMinVersion = &Version{Major: 2, Minor: 25, Patch: 0}
+	// commitGraphMinVersion is the minimum version required for `commit-graph`.
+	commitGraphMinVersion = &Version{Major: 2, Minor: 18, Patch: 0}
+	// blameIgnoreRevsFileMinVersion is the minimum version required for `blame --ignore-revs-file`.
+	blameIgnoreRevsFileMinVersion = &Version{Major: 2, Minor: 23, Patch: 0}
+)
+
+var (
+	defaultFeatures     *GitFeatures
+	defaultFeaturesErr  error
+	defaultFeaturesOnce sync.Once
+)
+
+// DefaultFeatures returns the GitFeatures computed from the currently
+// installed Git version. It is populated once on first call and reused
+// across goroutines. If Git is missing or its version cannot be determined,
+// it returns the zero-value GitFeatures, with every feature disabled, and
+// the error that prevented detection, so callers can degrade gracefully.
+func DefaultFeatures() (*GitFeatures, error) {
+	defaultFeaturesOnce.Do(func() {
+		version, err := GetGitVersion()
+		if err != nil {
+			defaultFeatures = &GitFeatures{}
+			defaultFeaturesErr = err
+			return
+		}
+		defaultFeatures = newGitFeatures(version)
+	})
+	return defaultFeatures, defaultFeaturesErr
+}
+
+func newGitFeatures(version *Version) *GitFeatures {
+	return &GitFeatures{
+		SupportsShallowSince:        version.AtLeast(shallowSinceMinVersion),
+		SupportsPartialClone:        version.AtLeast(partialCloneMinVersion),
+		SupportsSparseCheckoutCone:  version.AtLeast(sparseCheckoutConeMinVersion),
+		SupportsCommitGraph:         version.AtLeast(commitGraphMinVersion),
+		SupportsBlameIgnoreRevsFile: version.AtLeast(blameIgnoreRevsFileMinVersion),
+	}
+}
@@ -16,33 +16,27 @@ package git
 
 import (
 	"errors"
-	"fmt"
 	"os/exec"
-	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
 	"github.com/ZupIT/horusec/internal/helpers/messages"
 )
 
-const (
-	MinVersionGitAccept    = 2
-	MinSubVersionGitAccept = 0o1
-)
-
 var (
-	// ErrMinVersion is the error logged when the installed Git version is not the minimum supported.
-	ErrMinVersion = fmt.Errorf("%v.%v", MinVersionGitAccept, MinSubVersionGitAccept)
-
 	// ErrGitNotInstalled occurs when Git is not installed.
 	ErrGitNotInstalled = errors.New("git not found. Please check and try again")
 
 	// ErrGitLowerVersion occur when the installed Git version is not the minimum supported.
-	ErrGitLowerVersion = errors.New("git version is lower of 2.01. Please check and try again")
+	ErrGitLowerVersion = errors.New("git version is lower of 2.1.0. Please check and try again")
 )
 
-type RequirementGit struct{}
+type RequirementGit struct {
+	locatorOnce     sync.Once
+	locatorInstance *Locator
+}
 
 func NewRequirementGit() *RequirementGit {
 	return &RequirementGit{}
@@ -77,7 +71,12 @@ func (r *RequirementGit) validateIfGitIsSupported(version string) error {
 }
 
 func (r *RequirementGit) execGitVersion() (string, error) {
-	responseBytes, err := exec.Command("git", "--version").CombinedOutput()
+	binary, err := r.ResolveBinary()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWhenCheckRequirementsGit, err)
+		return "", err
+	}
+	responseBytes, err := exec.Command(binary, "--version").CombinedOutput()
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorWhenCheckRequirementsGit, err)
 		return "", err
@@ -86,40 +85,17 @@ func (r *RequirementGit) execGitVersion() (string, error) {
 }
 
 func (r *RequirementGit) validateIfGitIsRunningInMinVersion(response string) error {
-	version, subversion, err := r.extractGitVersionFromString(response)
+	version, err := Parse(response)
 	if err != nil {
 		return err
 	}
-	if version < MinVersionGitAccept {
-		logger.LogErrorWithLevel(messages.MsgErrorWhenGitIsLowerVersion, ErrMinVersion)
-		return ErrGitLowerVersion
-	} else if version == MinVersionGitAccept && subversion < MinSubVersionGitAccept {
-		logger.LogErrorWithLevel(messages.MsgErrorWhenGitIsLowerVersion, ErrMinVersion)
+	if !version.AtLeast(MinVersion) {
+		logger.LogErrorWithLevel(messages.MsgErrorWhenGitIsLowerVersion, ErrGitLowerVersion)
 		return ErrGitLowerVersion
 	}
 	return nil
 }
 
-func (r *RequirementGit) extractGitVersionFromString(response string) (int, int, error) {
-	responseSpited := strings.Split(strings.ToLower(response), "git version ")
-	if len(responseSpited) < 1 || len(responseSpited) > 1 && len(responseSpited[1]) < 3 {
-		return 0, 0, ErrGitNotInstalled
-	}
-	return r.getVersionAndSubVersion(responseSpited[1])
-}
-
 func (r *RequirementGit) checkIfContainsGitVersion(response string) bool {
 	return strings.Contains(strings.ToLower(response), "git version ")
 }
-
-func (r *RequirementGit) getVersionAndSubVersion(fullVersion string) (int, int, error) {
-	version, err := strconv.Atoi(fullVersion[0:1])
-	if err != nil {
-		return 0, 0, ErrGitNotInstalled
-	}
-	subversion, err := strconv.Atoi(fullVersion[2:4])
-	if err != nil {
-		return 0, 0, ErrGitNotInstalled
-	}
-	return version, subversion, nil
-}
@@ -0,0 +1,85 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+)
+
+// windowsGitPaths are the well-known install locations probed on Windows
+// when git.exe is not resolvable through the env vars or PATH, e.g. in a
+// restricted CI image.
+var windowsGitPaths = []string{
+	`C:\Program Files\Git\cmd\git.exe`,
+	`C:\Program Files (x86)\Git\cmd\git.exe`,
+}
+
+// Locator resolves the absolute path of the Git binary to use, honoring
+// HORUSEC_GIT_PATH and GIT_EXEC_PATH before falling back to PATH lookup, and
+// caches the result so every exec.Command("git", ...) site in the module
+// agrees on the same binary.
+type Locator struct {
+	once     sync.Once
+	resolved string
+	err      error
+}
+
+// ResolveBinary resolves and caches the absolute path of the Git binary,
+// in order: the HORUSEC_GIT_PATH env var, the GIT_EXEC_PATH env var,
+// exec.LookPath("git"), and finally, on Windows, the well-known
+// Program Files install locations.
+func (r *RequirementGit) ResolveBinary() (string, error) {
+	return r.locator().ResolveBinary()
+}
+
+func (r *RequirementGit) locator() *Locator {
+	r.locatorOnce.Do(func() {
+		r.locatorInstance = &Locator{}
+	})
+	return r.locatorInstance
+}
+
+// ResolveBinary resolves and caches the absolute path of the Git binary.
+func (l *Locator) ResolveBinary() (string, error) {
+	l.once.Do(func() {
+		l.resolved, l.err = resolveGitBinary()
+	})
+	return l.resolved, l.err
+}
+
+func resolveGitBinary() (string, error) {
+	if path := os.Getenv("HORUSEC_GIT_PATH"); path != "" {
+		return path, nil
+	}
+	if execPath := os.Getenv("GIT_EXEC_PATH"); execPath != "" {
+		if path, err := exec.LookPath(execPath + string(os.PathSeparator) + "git"); err == nil {
+			return path, nil
+		}
+	}
+	if path, err := exec.LookPath("git"); err == nil {
+		return path, nil
+	}
+	if runtime.GOOS == "windows" {
+		for _, path := range windowsGitPaths {
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+	}
+	return "", ErrGitNotInstalled
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// writeFakeGit creates a fake `git` executable on a temp directory that
+// prints output exactly as the given version string, then returns that
+// directory so the caller can prepend it to PATH.
+func writeFakeGit(t *testing.T, versionOutput string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake git shim is a POSIX shell script")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "git")
+	script := fmt.Sprintf("#!/bin/sh\necho %q\n", versionOutput)
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatalf("failed to write fake git binary: %v", err)
+	}
+	return dir
+}
+
+func withFakeGitOnPath(t *testing.T, versionOutput string) {
+	t.Helper()
+	dir := writeFakeGit(t, versionOutput)
+	t.Setenv("PATH", dir)
+	t.Setenv("HORUSEC_GIT_PATH", "")
+	t.Setenv("GIT_EXEC_PATH", "")
+}
+
+func TestRequirementGit_execGitVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		versionOutput string
+		wantVersion   *Version
+	}{
+		{
+			name:          "vanilla linux",
+			versionOutput: "git version 2.34.1",
+			wantVersion:   &Version{Major: 2, Minor: 34, Patch: 1},
+		},
+		{
+			name:          "apple git",
+			versionOutput: "git version 2.39.3 (Apple Git-145)",
+			wantVersion:   &Version{Major: 2, Minor: 39, Patch: 3},
+		},
+		{
+			name:          "windows git",
+			versionOutput: "git version 2.42.0.windows.1",
+			wantVersion:   &Version{Major: 2, Minor: 42, Patch: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeGitOnPath(t, tt.versionOutput)
+
+			r := NewRequirementGit()
+			response, err := r.execGitVersion()
+			if err != nil {
+				t.Fatalf("execGitVersion() returned error: %v", err)
+			}
+
+			got, err := Parse(response)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", response, err)
+			}
+			if *got != *tt.wantVersion {
+				t.Errorf("Parse(%q) = %+v, want %+v", response, got, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestResolveBinary_HonorsHorusecGitPath(t *testing.T) {
+	dir := writeFakeGit(t, "git version 2.34.1")
+	fakeGitPath := filepath.Join(dir, "git")
+	t.Setenv("HORUSEC_GIT_PATH", fakeGitPath)
+
+	r := NewRequirementGit()
+	got, err := r.ResolveBinary()
+	if err != nil {
+		t.Fatalf("ResolveBinary() returned error: %v", err)
+	}
+	if got != fakeGitPath {
+		t.Errorf("ResolveBinary() = %q, want %q", got, fakeGitPath)
+	}
+}
+
+func TestResolveBinary_CachesResult(t *testing.T) {
+	withFakeGitOnPath(t, "git version 2.34.1")
+
+	r := NewRequirementGit()
+	first, err := r.ResolveBinary()
+	if err != nil {
+		t.Fatalf("ResolveBinary() returned error: %v", err)
+	}
+
+	// Changing PATH after the first call must not affect the cached result.
+	t.Setenv("PATH", t.TempDir())
+	second, err := r.ResolveBinary()
+	if err != nil {
+		t.Fatalf("ResolveBinary() returned error on second call: %v", err)
+	}
+	if first != second {
+		t.Errorf("ResolveBinary() is not cached: first=%q second=%q", first, second)
+	}
+}
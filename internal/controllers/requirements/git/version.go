@@ -0,0 +1,137 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MinVersion is the minimum Git version required to run Horusec.
+var MinVersion = &Version{Major: 2, Minor: 1, Patch: 0}
+
+// digitsRegex extracts the leading digits of a version component, discarding
+// a trailing suffix such as "-dirty". Components after the patch, such as
+// "windows" or "rc1", are never parsed.
+var digitsRegex = regexp.MustCompile(`^\d+`)
+
+// Version represents a parsed Git semantic version in the Major.Minor.Patch form.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Parse extracts a Version from a raw `git --version` output, e.g.
+// "git version 2.39.3 (Apple Git-145)" or "git version 2.42.0.windows.1".
+// It tolerates a missing patch component, defaulting it to zero.
+func Parse(response string) (*Version, error) {
+	const prefix = "git version "
+	lowered := strings.ToLower(response)
+	idx := strings.Index(lowered, prefix)
+	if idx < 0 {
+		return nil, ErrGitNotInstalled
+	}
+	fields := strings.Fields(lowered[idx+len(prefix):])
+	if len(fields) == 0 {
+		return nil, ErrGitNotInstalled
+	}
+	return parseVersionToken(fields[0])
+}
+
+// ParseVersion parses a bare "Major.Minor[.Patch]" string, such as the
+// required version passed to RequireAtLeast, without the "git version "
+// prefix Parse expects.
+func ParseVersion(raw string) (*Version, error) {
+	return parseVersionToken(strings.ToLower(strings.TrimSpace(raw)))
+}
+
+func parseVersionToken(token string) (*Version, error) {
+	if paren := strings.IndexByte(token, '('); paren >= 0 {
+		token = token[:paren]
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) < 2 {
+		return nil, ErrGitNotInstalled
+	}
+	major, err := parseVersionPart(parts[0])
+	if err != nil {
+		return nil, ErrGitNotInstalled
+	}
+	minor, err := parseVersionPart(parts[1])
+	if err != nil {
+		return nil, ErrGitNotInstalled
+	}
+	patch := 0
+	if len(parts) > 2 {
+		if patch, err = parseVersionPart(parts[2]); err != nil {
+			patch = 0
+		}
+	}
+	return &Version{Major: major, Minor: minor, Patch: patch}, nil
+}
+
+func parseVersionPart(part string) (int, error) {
+	digits := digitsRegex.FindString(part)
+	if digits == "" {
+		return 0, fmt.Errorf("no version digits found in %q", part)
+	}
+	return strconv.Atoi(digits)
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is less than, equal to
+// or greater than other.
+func (v *Version) Compare(other *Version) int {
+	if diff := v.Major - other.Major; diff != 0 {
+		return sign(diff)
+	}
+	if diff := v.Minor - other.Minor; diff != 0 {
+		return sign(diff)
+	}
+	return sign(v.Patch - other.Patch)
+}
+
+// AtLeast reports whether v is greater than or equal to other.
+func (v *Version) AtLeast(other *Version) bool {
+	return v.Compare(other) >= 0
+}
+
+func (v *Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// GetGitVersion resolves and parses the installed Git version, so other
+// subsystems (e.g. leaks/history scanning) can gate features on it without
+// reimplementing version detection.
+func GetGitVersion() (*Version, error) {
+	response, err := NewRequirementGit().execGitVersion()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(response)
+}